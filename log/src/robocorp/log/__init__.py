@@ -1234,7 +1234,9 @@ def add_log_output(
         output_dir: The output directory where the log contents should be saved.
         max_file_size: The maximum file size for one log file (as a string with
             the value and the unit -- accepted units are: `b`, `kb`, `mb`, `gb`
-            if no unit is passed it's considered `b` (bytes)).
+            which are decimal (so, `1kb` is 1000 bytes) and `kib`, `mib`, `gib`
+            which are binary (so, `1kib` is 1024 bytes). Units are case-insensitive
+            and if no unit is passed it's considered `b` (bytes)).
             Note that the max size is not a hard guarantee, rather it's a
             guideline that the logging tries to follow (usually it's very close,
             although on degenerate cases it can be considerably different).
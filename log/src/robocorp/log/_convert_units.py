@@ -1,6 +1,10 @@
+import math
 import typing
 
 _convert = {
+    "gib": lambda s: s * (1024**3),
+    "mib": lambda s: s * (1024**2),
+    "kib": lambda s: s * 1024,
     "gb": lambda s: s * 1e9,
     "g": lambda s: s * 1e9,
     "mb": lambda s: s * 1e6,
@@ -21,10 +25,17 @@ def _convert_to_bytes(s: typing.Union[int, float, str]) -> int:
     while s and (s[0].isdigit() or s[0] == "."):
         num_lst.append(s[0])
         s = s[1:]
-    num = float("".join(num_lst))
+    try:
+        num = float("".join(num_lst))
+    except ValueError:
+        raise ValueError(f"Cannot get in bytes: {initial}") from None
     unit = s.strip()
     conv = _convert.get(unit.lower())
     if conv is None:
         raise ValueError(f"Cannot get in bytes: {initial}")
 
-    return int(conv(num))
+    in_bytes = conv(num)
+    if math.isinf(in_bytes):
+        raise ValueError(f"Cannot get in bytes (value too large): {initial}")
+
+    return int(in_bytes)
@@ -1,3 +1,6 @@
+import pytest
+
+
 def test_gen_id(data_regression):
     from robocorp.log._robo_output_impl import _gen_id
 
@@ -16,3 +19,26 @@ def test_convert():
     assert _convert_to_bytes("100kb") == 100000
     assert _convert_to_bytes("1mb") == 1e6
     assert _convert_to_bytes("0.1mb") == 1e5
+    assert _convert_to_bytes("2GB") == 2e9
+    assert _convert_to_bytes("2gb") == 2e9
+    assert _convert_to_bytes("1KiB") == 1024
+    assert _convert_to_bytes("1mib") == 1024**2
+    assert _convert_to_bytes("2GiB") == 2 * 1024**3
+
+
+def test_convert_errors():
+    from robocorp.log._convert_units import _convert_to_bytes
+
+    with pytest.raises(ValueError):
+        _convert_to_bytes("10xb")
+
+    with pytest.raises(ValueError):
+        _convert_to_bytes("mb")
+
+    with pytest.raises(ValueError):
+        # Too many digits to be represented as a float (becomes `inf`).
+        _convert_to_bytes(("9" * 400) + "gb")
+
+    with pytest.raises(ValueError):
+        # Fits in a float, but overflows once multiplied by the unit.
+        _convert_to_bytes(("9" * 308) + "gb")
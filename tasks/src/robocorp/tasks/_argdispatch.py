@@ -94,7 +94,7 @@ class _ArgDispatcher:
         run_parser.add_argument(
             "--max-log-file-size",
             dest="max_log_file_size",
-            help="The maximum size for the log files (i.e.: 1MB, 500kb).",
+            help="The maximum size for the log files (i.e.: 1MB, 500kb, 2GiB).",
             default="1MB",
         )
 